@@ -0,0 +1,71 @@
+package healthzhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/jasonhancock/healthz"
+)
+
+// EncodeText renders results as one line per check, sorted by name for
+// stable output: "[+] db ok" for a passing check, "[-] remote_service
+// failed: <error>" for a failing one.
+func EncodeText(w io.Writer, results map[string]*healthz.Response) error {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		r := results[name]
+		if r.Error != nil {
+			if _, err := fmt.Fprintf(w, "[-] %s failed: %s\n", name, r.Error); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "[+] %s ok\n", name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jsonCheckResult is the per-check shape rendered by EncodeJSON.
+type jsonCheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// jsonResult is the aggregate shape rendered by EncodeJSON:
+// {"status":"ok|fail","checks":{"name":{"status":"ok|fail","error":"..."}}}
+type jsonResult struct {
+	Status string                     `json:"status"`
+	Checks map[string]jsonCheckResult `json:"checks"`
+}
+
+// EncodeJSON renders results as
+// {"status":"ok|fail","checks":{"name":{"status":"ok|fail","error":"..."}}}.
+// The aggregate status is "fail" if any individual check failed.
+func EncodeJSON(w io.Writer, results map[string]*healthz.Response) error {
+	out := jsonResult{
+		Status: "ok",
+		Checks: make(map[string]jsonCheckResult, len(results)),
+	}
+
+	for name, r := range results {
+		cr := jsonCheckResult{Status: "ok"}
+		if r.Error != nil {
+			cr.Status = "fail"
+			cr.Error = r.Error.Error()
+			out.Status = "fail"
+		}
+		out.Checks[name] = cr
+	}
+
+	return json.NewEncoder(w).Encode(out)
+}
@@ -0,0 +1,49 @@
+package healthzhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cheekybits/is"
+	"github.com/jasonhancock/healthz"
+)
+
+func TestEncodeText(t *testing.T) {
+	is := is.New(t)
+
+	results := map[string]*healthz.Response{
+		"db":             {},
+		"remote_service": {Error: &ErrTransport{Err: fmt.Errorf("connection refused")}},
+	}
+
+	var buf bytes.Buffer
+	is.NoErr(EncodeText(&buf, results))
+
+	is.Equal(buf.String(), "[+] db ok\n[-] remote_service failed: transport error: connection refused\n")
+}
+
+func TestEncodeJSON(t *testing.T) {
+	is := is.New(t)
+
+	results := map[string]*healthz.Response{
+		"db": {},
+	}
+
+	var buf bytes.Buffer
+	is.NoErr(EncodeJSON(&buf, results))
+
+	var out jsonResult
+	is.NoErr(json.Unmarshal(buf.Bytes(), &out))
+	is.Equal(out.Status, "ok")
+	is.Equal(out.Checks["db"].Status, "ok")
+
+	results["remote_service"] = &healthz.Response{Error: &ErrUnexpectedStatus{StatusCode: 503}}
+	buf.Reset()
+	is.NoErr(EncodeJSON(&buf, results))
+	is.NoErr(json.Unmarshal(buf.Bytes(), &out))
+	is.Equal(out.Status, "fail")
+	is.Equal(out.Checks["remote_service"].Status, "fail")
+	is.Equal(out.Checks["remote_service"].Error, "unexpected http status code: 503")
+}
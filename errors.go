@@ -0,0 +1,75 @@
+package healthzhttp
+
+import "fmt"
+
+// These structured error types let a result's failure class be distinguished
+// programmatically (via errors.As) instead of matching on Error() strings.
+// They give the built-in response encoders (see encode.go) something to
+// differentiate on. Wiring a pluggable encoder into healthz.Checker itself
+// (WithResponseEncoder) is a different matter: Checker's check map and JSON
+// rendering in ServeHTTP are private with no override point, so that hook
+// isn't implemented here - it's blocked on an upstream change to healthz.
+
+// ErrUnexpectedStatus indicates the response's HTTP status code was not in
+// the set of allowed status codes.
+type ErrUnexpectedStatus struct {
+	StatusCode int
+}
+
+func (e *ErrUnexpectedStatus) Error() string {
+	return fmt.Sprintf("unexpected http status code: %d", e.StatusCode)
+}
+
+// ErrBodyMismatch indicates the response body did not satisfy one of the
+// configured content assertions (WithRegexp). WithJSONPath failures are
+// reported as ErrJSONPathMismatch instead.
+type ErrBodyMismatch struct {
+	Reason string
+}
+
+func (e *ErrBodyMismatch) Error() string {
+	return fmt.Sprintf("response body mismatch: %s", e.Reason)
+}
+
+// ErrTransport wraps a lower-level error encountered while making the
+// request or reading the response, as opposed to an assertion failing
+// against a successfully received response.
+type ErrTransport struct {
+	Err error
+}
+
+func (e *ErrTransport) Error() string {
+	return fmt.Sprintf("transport error: %s", e.Err)
+}
+
+func (e *ErrTransport) Unwrap() error {
+	return e.Err
+}
+
+// ErrJSONPathMismatch indicates a WithJSONPath assertion failed, either
+// because the expression didn't resolve to a value or the value resolved
+// didn't equal what was expected.
+type ErrJSONPathMismatch struct {
+	Expr     string
+	Expected interface{}
+	Actual   interface{}
+	Reason   string
+}
+
+func (e *ErrJSONPathMismatch) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("jsonpath %q: %s", e.Expr, e.Reason)
+	}
+	return fmt.Sprintf("jsonpath %q: expected %v, got %v", e.Expr, e.Expected, e.Actual)
+}
+
+// ErrHeaderMismatch indicates a WithResponseHeader assertion failed because
+// the named header was missing or didn't match the expected regex.
+type ErrHeaderMismatch struct {
+	Header string
+	Regex  string
+}
+
+func (e *ErrHeaderMismatch) Error() string {
+	return fmt.Sprintf("response header %q did not match regexp %q", e.Header, e.Regex)
+}
@@ -0,0 +1,66 @@
+package healthzhttp
+
+import (
+	"net/http"
+	"time"
+)
+
+type handlerOptions struct {
+	maxInFlight int
+	timeout     time.Duration
+}
+
+// HandlerOption customizes Wrap.
+type HandlerOption func(*handlerOptions)
+
+// WithMaxInFlight limits the handler to n concurrent in-flight requests,
+// returning 429 Too Many Requests for anything over that. This protects
+// against a pile-up of goroutines when a slow check (e.g. CheckHTTP hitting
+// an unresponsive target) is hit repeatedly by load-balancer probing.
+func WithMaxInFlight(n int) HandlerOption {
+	return func(o *handlerOptions) {
+		o.maxInFlight = n
+	}
+}
+
+// WithHandlerTimeout wraps the handler in http.TimeoutHandler with the given
+// duration, cancelling the context passed to each Check once it elapses.
+func WithHandlerTimeout(d time.Duration) HandlerOption {
+	return func(o *handlerOptions) {
+		o.timeout = d
+	}
+}
+
+// Wrap adds concurrency limiting and/or a request timeout around h, which is
+// typically a *healthz.Checker (it satisfies http.Handler). With no options,
+// Wrap returns h unchanged.
+func Wrap(h http.Handler, opts ...HandlerOption) http.Handler {
+	var o handlerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.maxInFlight > 0 {
+		h = maxInFlight(h, o.maxInFlight)
+	}
+	if o.timeout > 0 {
+		h = http.TimeoutHandler(h, o.timeout, "health check timed out")
+	}
+
+	return h
+}
+
+// maxInFlight limits h to n concurrent requests, rejecting the rest with a
+// 429 rather than queuing them.
+func maxInFlight(h http.Handler, n int) http.Handler {
+	sem := make(chan struct{}, n)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case sem <- struct{}{}:
+			defer func() { <-sem }()
+			h.ServeHTTP(w, r)
+		default:
+			http.Error(w, "too many in-flight health check requests", http.StatusTooManyRequests)
+		}
+	})
+}
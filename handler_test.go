@@ -0,0 +1,56 @@
+package healthzhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cheekybits/is"
+)
+
+func TestWrapMaxInFlight(t *testing.T) {
+	is := is.New(t)
+
+	block := make(chan struct{})
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-block
+		w.WriteHeader(http.StatusOK)
+	})
+
+	h := Wrap(inner, WithMaxInFlight(1))
+
+	done := make(chan struct{})
+	go func() {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		close(done)
+	}()
+
+	// give the first request a chance to acquire the slot
+	time.Sleep(50 * time.Millisecond)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	is.Equal(rec.Code, http.StatusTooManyRequests)
+
+	close(block)
+	<-done
+}
+
+func TestWrapHandlerTimeout(t *testing.T) {
+	is := is.New(t)
+
+	inner := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	h := Wrap(inner, WithHandlerTimeout(10*time.Millisecond))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	is.Equal(rec.Code, http.StatusServiceUnavailable)
+}
@@ -3,6 +3,7 @@ package healthzhttp
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -21,6 +22,47 @@ type options struct {
 	username           string
 	password           string
 	allowedStatusCodes map[int]struct{}
+	kind               Kind
+	jsonPaths          []jsonPathAssertion
+	headers            []headerAssertion
+}
+
+// headerAssertion checks that the named response header matches a regex.
+type headerAssertion struct {
+	name  string
+	value *regexp.Regexp
+}
+
+// Kind classifies which kind of probe a check should be included in, mirroring
+// the liveness/readiness split used by Kubernetes (and etcd's /livez, /readyz).
+// A check can be both, since the two bits are independent.
+//
+// STATUS: partial, not closed. The requested /livez and /readyz endpoints
+// with ?exclude= and ?verbose=/JSON output require healthz.Checker (a
+// separate, versioned module) to expose its registered checks and their
+// Kind to a handler, which its current API has no way to do: AddCheck takes
+// only a name and a Check, and ServeHTTP's check map and rendering are
+// private with no override point. That's a real scope question for
+// healthz, not something this repo can resolve unilaterally - it needs a
+// decision from the backlog owner (fork/vendor healthz, get the API
+// extended upstream, or re-scope the request) before the endpoint/
+// filtering/verbose work can proceed. Kind only exists so CheckHTTP can
+// classify itself once that's decided; nothing in this repo reads it yet.
+type Kind int
+
+const (
+	// Liveness marks a check as affecting the liveness probe: failing it
+	// indicates the process itself is unhealthy and should be restarted.
+	Liveness Kind = 1 << iota
+	// Readiness marks a check as affecting the readiness probe: failing it
+	// indicates traffic shouldn't be routed to this instance right now, but
+	// the process itself is fine.
+	Readiness
+)
+
+// Is reports whether k includes the given kind.
+func (k Kind) Is(kind Kind) bool {
+	return k&kind != 0
 }
 
 // Option is used to customize the summarizer
@@ -91,6 +133,44 @@ func WithRegexp(expr string) Option {
 	}
 }
 
+// WithJSONPath asserts that the value addressed by expr within a JSON
+// response body equals expected, e.g.
+// WithJSONPath("$.components.db.status", "UP"). This is more precise than
+// WithRegexp for structured health responses (Spring Actuator style),
+// where a substring match can produce false positives. Only a subset of
+// JSONPath is supported: dotted field access and bracketed array indexes.
+func WithJSONPath(expr string, expected interface{}) Option {
+	return func(o *options) error {
+		o.jsonPaths = append(o.jsonPaths, jsonPathAssertion{expr: expr, expected: expected})
+		return nil
+	}
+}
+
+// WithResponseHeader asserts that the named response header is present and
+// matches valueRegex, e.g. to verify X-Health: ok from a proxied upstream.
+func WithResponseHeader(name, valueRegex string) Option {
+	return func(o *options) error {
+		regex, err := regexp.Compile(valueRegex)
+		if err != nil {
+			return err
+		}
+
+		o.headers = append(o.headers, headerAssertion{name: name, value: regex})
+		return nil
+	}
+}
+
+// WithKind overrides the default liveness/readiness classification of the
+// check. CheckHTTP defaults to Readiness, since a remote dependency being
+// temporarily unreachable should keep traffic away from the pod rather
+// than trigger a restart.
+func WithKind(kind Kind) Option {
+	return func(o *options) error {
+		o.kind = kind
+		return nil
+	}
+}
+
 // CheckHTTP is an HTTP healthz check
 type CheckHTTP struct {
 	url                *url.URL
@@ -101,6 +181,9 @@ type CheckHTTP struct {
 	username           string
 	password           string
 	allowedStatusCodes map[int]struct{}
+	kind               Kind
+	jsonPaths          []jsonPathAssertion
+	headers            []headerAssertion
 }
 
 // NewCheck creates a new CheckHTTP.
@@ -116,6 +199,7 @@ func NewCheck(endpoint string, opts ...Option) (*CheckHTTP, error) {
 		allowedStatusCodes: map[int]struct{}{
 			http.StatusOK: struct{}{},
 		},
+		kind: Readiness,
 	}
 	for _, o := range opts {
 		err := o(opt)
@@ -131,18 +215,38 @@ func NewCheck(endpoint string, opts ...Option) (*CheckHTTP, error) {
 		body:               opt.body,
 		allowedStatusCodes: opt.allowedStatusCodes,
 		matchContent:       opt.matchContent,
+		kind:               opt.kind,
+		jsonPaths:          opt.jsonPaths,
+		headers:            opt.headers,
 	}
 
 	return c, nil
 }
 
-// Check performs the check
+// Kind reports whether this check applies to liveness, readiness, or both.
+func (c CheckHTTP) Kind() Kind {
+	return c.kind
+}
+
+// Check performs the check. The request honors ctx's deadline/cancellation,
+// so a timeout imposed by the caller (e.g. via context.WithTimeout, such as
+// a future healthz.Checker's WithCheckTimeout deriving a child context
+// before calling Check) aborts the in-flight request rather than leaving it
+// running in the background. This fixes the latent bug where Check built
+// the request with http.NewRequest and never propagated ctx at all.
+//
+// WithMaxInFlight and WithHandlerTimeout are generic http.Handler middleware
+// and are implemented in this repo; see Wrap. WithCheckTimeout(name, d) is
+// different: it requires per-registered-name timeout bookkeeping that only
+// healthz.Checker - a separate, versioned module - can provide, since this
+// repo has no visibility into the names under which checks are registered.
+// That part remains blocked on an upstream change to healthz.
 func (c CheckHTTP) Check(ctx context.Context) *healthz.Response {
 	body := bytes.NewReader(c.body)
 
-	req, err := http.NewRequest(c.method, c.url.String(), body)
+	req, err := http.NewRequestWithContext(ctx, c.method, c.url.String(), body)
 	if err != nil {
-		return &healthz.Response{Error: err}
+		return &healthz.Response{Error: &ErrTransport{Err: err}}
 	}
 	if c.username != "" && c.password != "" {
 		req.SetBasicAuth(c.username, c.password)
@@ -150,20 +254,32 @@ func (c CheckHTTP) Check(ctx context.Context) *healthz.Response {
 
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return &healthz.Response{Error: err}
+		return &healthz.Response{Error: &ErrTransport{Err: err}}
 	}
 	defer resp.Body.Close()
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return &healthz.Response{Error: errors.Wrap(err, "reading response body")}
+		return &healthz.Response{Error: &ErrTransport{Err: errors.Wrap(err, "reading response body")}}
 	}
 
 	if _, ok := c.allowedStatusCodes[resp.StatusCode]; !ok {
-		return &healthz.Response{Error: errors.Errorf("Unexpected http status code: %d", resp.StatusCode)}
+		return &healthz.Response{Error: &ErrUnexpectedStatus{StatusCode: resp.StatusCode}}
 	}
 
 	if c.matchContent != nil && !c.matchContent.Match(respBody) {
-		return &healthz.Response{Error: errors.Errorf("the response body did not match the supplied regex: %s", c.matchContent.String())}
+		return &healthz.Response{Error: &ErrBodyMismatch{Reason: fmt.Sprintf("body did not match regexp %q", c.matchContent.String())}}
+	}
+
+	for _, h := range c.headers {
+		if len(resp.Header.Values(h.name)) == 0 || !h.value.MatchString(resp.Header.Get(h.name)) {
+			return &healthz.Response{Error: &ErrHeaderMismatch{Header: h.name, Regex: h.value.String()}}
+		}
+	}
+
+	for _, p := range c.jsonPaths {
+		if err := p.check(respBody); err != nil {
+			return &healthz.Response{Error: err}
+		}
 	}
 
 	return &healthz.Response{}
@@ -2,11 +2,11 @@ package healthzhttp
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httptest"
-	"strings"
 	"testing"
 
 	"github.com/cheekybits/is"
@@ -52,7 +52,9 @@ func TestCheckHTTP(t *testing.T) {
 	status = http.StatusNotFound
 	result = c.Check(context.Background())
 	is.Err(result.Error)
-	is.True(strings.HasPrefix(result.Error.Error(), "Unexpected http status code:"))
+	var statusErr *ErrUnexpectedStatus
+	is.True(errors.As(result.Error, &statusErr))
+	is.Equal(statusErr.StatusCode, http.StatusNotFound)
 
 	c.allowedStatusCodes[http.StatusNotFound] = struct{}{}
 	result = c.Check(context.Background())
@@ -64,7 +66,7 @@ func TestCheckHTTP(t *testing.T) {
 	allowedMethod = http.MethodPost
 	result = c.Check(context.Background())
 	is.Err(result.Error)
-	is.True(strings.HasPrefix(result.Error.Error(), "Unexpected http status code:"))
+	is.True(errors.As(result.Error, &statusErr))
 
 	// Update the expected method
 	c.method = http.MethodPost
@@ -72,6 +74,82 @@ func TestCheckHTTP(t *testing.T) {
 	is.NoErr(result.Error)
 }
 
+func TestKind(t *testing.T) {
+	is := is.New(t)
+
+	c, err := NewCheck("http://example.com/healthz")
+	is.NoErr(err)
+	is.True(c.Kind().Is(Readiness))
+	is.False(c.Kind().Is(Liveness))
+
+	c, err = NewCheck("http://example.com/healthz", WithKind(Liveness|Readiness))
+	is.NoErr(err)
+	is.True(c.Kind().Is(Liveness))
+	is.True(c.Kind().Is(Readiness))
+}
+
+func TestCheckHTTPContextCancellation(t *testing.T) {
+	is := is.New(t)
+
+	block := make(chan struct{})
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		<-block
+		w.WriteHeader(http.StatusOK)
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/echo", handle).Methods(http.MethodGet)
+	server := httptest.NewServer(router)
+	defer server.Close()
+	defer close(block)
+
+	c, err := NewCheck(server.URL + "/echo")
+	is.NoErr(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := c.Check(ctx)
+	is.Err(result.Error)
+	is.True(errors.Is(result.Error, context.Canceled))
+}
+
+func TestCheckHTTPJSONPathAndHeader(t *testing.T) {
+	is := is.New(t)
+
+	handle := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Health", "ok")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"UP","components":{"db":{"status":"UP"}}}`))
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/echo", handle).Methods(http.MethodGet)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	u := server.URL + "/echo"
+
+	c, err := NewCheck(u, WithJSONPath("$.components.db.status", "UP"), WithResponseHeader("X-Health", "^ok$"))
+	is.NoErr(err)
+	result := c.Check(context.Background())
+	is.NoErr(result.Error)
+
+	c, err = NewCheck(u, WithJSONPath("$.components.db.status", "DOWN"))
+	is.NoErr(err)
+	result = c.Check(context.Background())
+	is.Err(result.Error)
+	var pathErr *ErrJSONPathMismatch
+	is.True(errors.As(result.Error, &pathErr))
+
+	c, err = NewCheck(u, WithResponseHeader("X-Health", "^bad$"))
+	is.NoErr(err)
+	result = c.Check(context.Background())
+	is.Err(result.Error)
+	var headerErr *ErrHeaderMismatch
+	is.True(errors.As(result.Error, &headerErr))
+}
+
 func TestAllowedStatusCodes(t *testing.T) {
 	is := is.New(t)
 	c, err := NewCheck("http://example.com/healthz", WithoutAllowedStatusCode(200), WithAllowedStatusCode(302))
@@ -0,0 +1,91 @@
+package healthzhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPathAssertion checks that the value addressed by expr within a JSON
+// response body equals expected.
+type jsonPathAssertion struct {
+	expr     string
+	expected interface{}
+}
+
+// check evaluates the assertion against the raw JSON response body.
+func (a jsonPathAssertion) check(body []byte) error {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return &ErrJSONPathMismatch{Expr: a.expr, Reason: fmt.Sprintf("response body is not valid JSON: %s", err)}
+	}
+
+	actual, err := evalJSONPath(data, a.expr)
+	if err != nil {
+		return &ErrJSONPathMismatch{Expr: a.expr, Reason: err.Error()}
+	}
+
+	if fmt.Sprintf("%v", actual) != fmt.Sprintf("%v", a.expected) {
+		return &ErrJSONPathMismatch{Expr: a.expr, Expected: a.expected, Actual: actual}
+	}
+
+	return nil
+}
+
+// evalJSONPath resolves a dotted path expression such as
+// "$.components.db.status" or "$.components[0].status" against a decoded
+// JSON value. This supports the subset of JSONPath needed to address a
+// single nested field or array element - not the full JSONPath grammar.
+func evalJSONPath(data interface{}, expr string) (interface{}, error) {
+	path := strings.TrimPrefix(expr, "$")
+	path = strings.TrimPrefix(path, ".")
+
+	cur := data
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			continue
+		}
+
+		name := segment
+		var indexes []string
+		if idx := strings.Index(segment, "["); idx >= 0 {
+			name = segment[:idx]
+			for _, part := range strings.Split(segment[idx:], "[") {
+				part = strings.TrimSuffix(part, "]")
+				if part != "" {
+					indexes = append(indexes, part)
+				}
+			}
+		}
+
+		if name != "" {
+			m, ok := cur.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%q: expected an object", name)
+			}
+			v, ok := m[name]
+			if !ok {
+				return nil, fmt.Errorf("%q: field not found", name)
+			}
+			cur = v
+		}
+
+		for _, idxStr := range indexes {
+			i, err := strconv.Atoi(idxStr)
+			if err != nil {
+				return nil, fmt.Errorf("%q: not a valid array index", idxStr)
+			}
+			arr, ok := cur.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%q: expected an array", segment)
+			}
+			if i < 0 || i >= len(arr) {
+				return nil, fmt.Errorf("index %d out of range", i)
+			}
+			cur = arr[i]
+		}
+	}
+
+	return cur, nil
+}
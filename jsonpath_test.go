@@ -0,0 +1,34 @@
+package healthzhttp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cheekybits/is"
+)
+
+func TestEvalJSONPath(t *testing.T) {
+	is := is.New(t)
+
+	var data interface{}
+	err := json.Unmarshal([]byte(`{"status":"UP","components":{"db":{"status":"UP"}},"checks":[{"name":"disk"}]}`), &data)
+	is.NoErr(err)
+
+	v, err := evalJSONPath(data, "$.status")
+	is.NoErr(err)
+	is.Equal(v, "UP")
+
+	v, err = evalJSONPath(data, "$.components.db.status")
+	is.NoErr(err)
+	is.Equal(v, "UP")
+
+	v, err = evalJSONPath(data, "$.checks[0].name")
+	is.NoErr(err)
+	is.Equal(v, "disk")
+
+	_, err = evalJSONPath(data, "$.components.missing")
+	is.Err(err)
+
+	_, err = evalJSONPath(data, "$.checks[5].name")
+	is.Err(err)
+}